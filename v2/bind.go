@@ -0,0 +1,43 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Bind requests a new binding between a service instance and an application
+// and returns the BindResponse or an error. If r.AcceptsIncomplete is set,
+// the broker may respond asynchronously; callers should check
+// BindResponse.Async and poll PollBindingLastOperation until it completes.
+func (c *client) Bind(r *BindRequest) (*BindResponse, error) {
+	fullURL := fmt.Sprintf(bindingURLFmt, c.URL, r.InstanceID, r.BindingID)
+
+	fullURL, err := appendAcceptsIncomplete(fullURL, r.AcceptsIncomplete && c.APIVersion.AtLeast(Version2_14()))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.prepareAndDoRequest(http.MethodPut, fullURL, r, r.OriginatingIdentity, r.RetryConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		userResponse := &BindResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		return userResponse, nil
+	case http.StatusAccepted:
+		userResponse := &BindResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		userResponse.Async = true
+		return userResponse, nil
+	default:
+		return nil, c.handleFailureResponse(response)
+	}
+}
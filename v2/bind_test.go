@@ -0,0 +1,109 @@
+package v2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestBroker(t *testing.T, apiVersion APIVersion, handler http.HandlerFunc) Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(&ClientConfiguration{
+		Name:           "test-broker",
+		URL:            server.URL,
+		APIVersion:     apiVersion,
+		TimeoutSeconds: 5,
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func TestBindAsyncResponse(t *testing.T) {
+	c := newTestBroker(t, Version2_14(), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, jsonType)
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{}`))
+	})
+
+	resp, err := c.Bind(&BindRequest{
+		InstanceID:        "instance-1",
+		BindingID:         "binding-1",
+		ServiceID:         "svc",
+		PlanID:            "plan",
+		AcceptsIncomplete: true,
+	})
+	if err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	if !resp.Async {
+		t.Error("Async = false, want true for a 202 response")
+	}
+}
+
+func TestBindAcceptsIncompleteNotSentBelow2_14(t *testing.T) {
+	var gotQuery string
+	c := newTestBroker(t, Version2_13(), func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Header().Set(contentType, jsonType)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{}`))
+	})
+
+	if _, err := c.Bind(&BindRequest{
+		InstanceID:        "instance-1",
+		BindingID:         "binding-1",
+		ServiceID:         "svc",
+		PlanID:            "plan",
+		AcceptsIncomplete: true,
+	}); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+
+	if gotQuery != "" {
+		t.Errorf("query = %q, want empty (accepts_incomplete is a 2.14 feature)", gotQuery)
+	}
+}
+
+func TestUnbindAsyncResponse(t *testing.T) {
+	c := newTestBroker(t, Version2_14(), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, jsonType)
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{}`))
+	})
+
+	resp, err := c.Unbind(&UnbindRequest{
+		InstanceID:        "instance-1",
+		BindingID:         "binding-1",
+		ServiceID:         "svc",
+		PlanID:            "plan",
+		AcceptsIncomplete: true,
+	})
+	if err != nil {
+		t.Fatalf("Unbind: %v", err)
+	}
+	if !resp.Async {
+		t.Error("Async = false, want true for a 202 response")
+	}
+}
+
+func TestGetBindingRequiresAPIVersion2_14(t *testing.T) {
+	var called bool
+	c := newTestBroker(t, Version2_13(), func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := c.GetBinding(&GetBindingRequest{InstanceID: "instance-1", BindingID: "binding-1"})
+	if _, ok := err.(OperationNotAllowedError); !ok {
+		t.Fatalf("err = %T(%v), want OperationNotAllowedError", err, err)
+	}
+	if called {
+		t.Error("expected no request to be sent when the API version is too low")
+	}
+}
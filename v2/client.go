@@ -3,16 +3,17 @@ package v2
 import (
 	"bytes"
 	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
-
-	"github.com/golang/glog"
 )
 
 const (
@@ -20,11 +21,16 @@ const (
 	// version.
 	XBrokerAPIVersion = "X-Broker-Api-Version"
 
-	catalogURL            = "%s/v2/catalog"
-	serviceInstanceURLFmt = "%s/v2/service_instances/%s"
-	lastOperationURLFmt   = "%s/v2/service_instances/%s/last_operation"
-	bindingURLFmt         = "%s/v2/service_instances/%s/service_bindings/%s"
-	queryParamFmt         = "%s=%s"
+	// XBrokerAPIOriginatingIdentity is the header carrying the identity of
+	// the end user that triggered a given request.
+	XBrokerAPIOriginatingIdentity = "X-Broker-Api-Originating-Identity"
+
+	catalogURL                 = "%s/v2/catalog"
+	serviceInstanceURLFmt      = "%s/v2/service_instances/%s"
+	lastOperationURLFmt        = "%s/v2/service_instances/%s/last_operation"
+	bindingURLFmt              = "%s/v2/service_instances/%s/service_bindings/%s"
+	bindingLastOperationURLFmt = "%s/v2/service_instances/%s/service_bindings/%s/last_operation"
+	queryParamFmt              = "%s=%s"
 )
 
 // NewClient is a CreateFunc for creating a new functional Client and
@@ -44,20 +50,46 @@ func NewClient(config *ClientConfiguration) (Client, error) {
 	}
 	httpClient.Transport = transport
 
+	logger := config.Logger
+	if logger == nil {
+		logger = noopLogger{}
+	}
+
 	c := &client{
-		Name:                config.Name,
-		URL:                 strings.TrimRight(config.URL, "/"),
-		APIVersion:          config.APIVersion,
-		EnableAlphaFeatures: config.EnableAlphaFeatures,
-		httpClient:          httpClient,
+		Name:                      config.Name,
+		URL:                       strings.TrimRight(config.URL, "/"),
+		APIVersion:                config.APIVersion,
+		EnableAlphaFeatures:       config.EnableAlphaFeatures,
+		EnableOriginatingIdentity: config.EnableOriginatingIdentity,
+		Logger:                    logger,
+		LogPayloads:               config.LogPayloads,
+		RetryConfig:               config.RetryConfig,
+		httpClient:                httpClient,
 	}
 
 	if config.AuthConfig != nil {
-		if config.AuthConfig.BasicAuthConfig == nil {
-			return nil, errors.New("BasicAuthConfig is required is AuthConfig is provided")
+		configured := 0
+		if config.AuthConfig.BasicAuthConfig != nil {
+			configured++
+		}
+		if config.AuthConfig.BearerConfig != nil {
+			configured++
+		}
+		if config.AuthConfig.OAuth2ClientCredentialsConfig != nil {
+			configured++
+		}
+		if configured != 1 {
+			return nil, errors.New("AuthConfig must set exactly one of BasicAuthConfig, BearerConfig, or OAuth2ClientCredentialsConfig")
 		}
 
-		c.BasicAuthConfig = config.AuthConfig.BasicAuthConfig
+		switch {
+		case config.AuthConfig.BasicAuthConfig != nil:
+			c.BasicAuthConfig = config.AuthConfig.BasicAuthConfig
+		case config.AuthConfig.BearerConfig != nil:
+			c.BearerConfig = config.AuthConfig.BearerConfig
+		case config.AuthConfig.OAuth2ClientCredentialsConfig != nil:
+			httpClient.Transport = newOAuth2Transport(config.AuthConfig.OAuth2ClientCredentialsConfig, transport)
+		}
 	}
 
 	return c, nil
@@ -67,12 +99,16 @@ var _ CreateFunc = NewClient
 
 // client provides a functional implementation of the Client interface.
 type client struct {
-	Name                string
-	URL                 string
-	APIVersion          APIVersion
-	BasicAuthConfig     *BasicAuthConfig
-	EnableAlphaFeatures bool
-	Verbose             bool
+	Name                      string
+	URL                       string
+	APIVersion                APIVersion
+	BasicAuthConfig           *BasicAuthConfig
+	BearerConfig              *BearerConfig
+	EnableAlphaFeatures       bool
+	EnableOriginatingIdentity bool
+	Logger                    Logger
+	LogPayloads               bool
+	RetryConfig               *RetryConfig
 
 	httpClient *http.Client
 }
@@ -89,6 +125,9 @@ var _ Client = &client{}
 // PollLastOperation: poll_last_operation.go
 // Bind: bind.go
 // Unbind: unbind.go
+// GetBinding: get_binding.go
+// PollBindingLastOperation: poll_binding_last_operation.go
+// GetInstance: get_instance.go
 
 const (
 	contentType = "Content-Type"
@@ -98,37 +137,197 @@ const (
 // prepareAndDoRequest prepares a request for the given method, URL, and
 // message body, and executes the request, returning an http.Response or an
 // error.  Errors returned from this function represent http-layer errors and
-// not errors in the Open Service Broker API.
-func (c *client) prepareAndDoRequest(method, URL string, body interface{}) (*http.Response, error) {
-	var bodyReader io.Reader
-
+// not errors in the Open Service Broker API. originatingIdentity, if
+// non-nil, is sent via the X-Broker-Api-Originating-Identity header when the
+// client is configured to do so and the negotiated APIVersion supports it.
+// retryConfig, if non-nil, overrides c.RetryConfig for this request; pass
+// the request's RetryConfig field through so callers can opt a specific
+// call out of (or into a different) retry behavior than the client default.
+func (c *client) prepareAndDoRequest(method, URL string, body interface{}, originatingIdentity *OriginatingIdentity, retryConfig *RetryConfig) (*http.Response, error) {
+	var bodyBytes []byte
 	if body != nil {
-		bodyBytes, err := json.Marshal(body)
+		var err error
+		bodyBytes, err = json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
+	}
+
+	effectiveRetryConfig := c.RetryConfig
+	if retryConfig != nil {
+		effectiveRetryConfig = retryConfig
+	}
+
+	maxAttempts := 1
+	if effectiveRetryConfig != nil && effectiveRetryConfig.MaxAttempts > 1 {
+		maxAttempts = effectiveRetryConfig.MaxAttempts
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		var bodyReader io.Reader
+		if bodyBytes != nil {
+			bodyReader = bytes.NewReader(bodyBytes)
+		}
+
+		request, err := http.NewRequest(method, URL, bodyReader)
+		if err != nil {
+			return nil, err
+		}
+
+		request.Header.Set(XBrokerAPIVersion, string(c.APIVersion))
+		if bodyReader != nil {
+			request.Header.Set(contentType, jsonType)
+		}
+		if c.BasicAuthConfig != nil {
+			request.SetBasicAuth(c.BasicAuthConfig.Username, c.BasicAuthConfig.Password)
+		} else if c.BearerConfig != nil {
+			request.Header.Set("Authorization", "Bearer "+c.BearerConfig.Token)
+		}
+		if c.EnableOriginatingIdentity && c.APIVersion.AtLeast(Version2_13()) {
+			if err := setOriginatingIdentityHeader(request, originatingIdentity); err != nil {
+				return nil, err
+			}
+		}
+
+		if c.LogPayloads && bodyBytes != nil {
+			c.Logger.Debug("sending request", "broker", c.Name, "method", method, "url", URL, "body", string(bodyBytes), "attempt", attempt)
+		}
+
+		start := time.Now()
+		response, err := c.httpClient.Do(request)
+		elapsed := time.Since(start)
+
+		statusCode := 0
+		if response != nil {
+			statusCode = response.StatusCode
+		}
+		if err != nil {
+			c.Logger.Error("request failed", "broker", c.Name, "method", method, "url", URL, "elapsed", elapsed, "attempt", attempt, "error", err)
+		} else {
+			c.Logger.Info("did request", "broker", c.Name, "method", method, "url", URL, "status", statusCode, "elapsed", elapsed, "attempt", attempt)
+		}
+
+		if attempt == maxAttempts || !c.shouldRetry(effectiveRetryConfig, statusCode, err) {
+			return response, err
+		}
+
+		delay := c.retryDelay(effectiveRetryConfig, attempt, response)
+		if response != nil {
+			response.Body.Close()
+		}
+		if effectiveRetryConfig.OnRetry != nil {
+			effectiveRetryConfig.OnRetry(attempt, statusCode, err, delay)
+		}
+		lastErr = err
+		time.Sleep(delay)
+	}
+
+	return nil, lastErr
+}
+
+// shouldRetry returns whether a request that got statusCode (or failed with
+// err) should be retried under retryConfig. A transport-layer error is
+// always retried; an HTTP response is retried only if its status is in
+// RetryConfig.RetryStatusCodes.
+func (c *client) shouldRetry(retryConfig *RetryConfig, statusCode int, err error) bool {
+	if retryConfig == nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	for _, code := range retryConfig.RetryStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// retryDelay returns how long to sleep before the next attempt, preferring
+// a broker-supplied Retry-After header on 429/503 responses over the
+// backoff configured in retryConfig.
+func (c *client) retryDelay(retryConfig *RetryConfig, attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if retryAfter := parseRetryAfter(response); retryAfter > 0 {
+			return retryAfter
+		}
+	}
+
+	backoff := retryConfig.InitialBackoff << uint(attempt-1)
+	if retryConfig.MaxBackoff > 0 && backoff > retryConfig.MaxBackoff {
+		backoff = retryConfig.MaxBackoff
+	}
+	if !retryConfig.Jitter || backoff <= 0 {
+		return backoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
 
-		bodyReader = bytes.NewReader(bodyBytes)
+// parseRetryAfter returns the delay requested by a 429 or 503 response's
+// Retry-After header, or zero if the response carries none.
+func parseRetryAfter(response *http.Response) time.Duration {
+	if response.StatusCode != http.StatusTooManyRequests && response.StatusCode != http.StatusServiceUnavailable {
+		return 0
 	}
 
-	request, err := http.NewRequest(method, URL, bodyReader)
+	header := response.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
+
+// setOriginatingIdentityHeader sets the
+// X-Broker-Api-Originating-Identity header on request from identity. It is
+// a no-op if identity is nil.
+func setOriginatingIdentityHeader(request *http.Request, identity *OriginatingIdentity) error {
+	if identity == nil {
+		return nil
+	}
+
+	valueBytes, err := json.Marshal(identity.Value)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	request.Header.Set(XBrokerAPIVersion, string(c.APIVersion))
-	if bodyReader != nil {
-		request.Header.Set(contentType, jsonType)
+	encodedValue := base64.StdEncoding.EncodeToString(valueBytes)
+	request.Header.Set(XBrokerAPIOriginatingIdentity, fmt.Sprintf("%s %s", identity.Platform, encodedValue))
+	return nil
+}
+
+// requireAPIVersion returns an OperationNotAllowedError if c.APIVersion is
+// lower than the given version, and nil otherwise. Callers use this to
+// reject operations that the negotiated broker API version does not support
+// before ever issuing a request.
+func (c *client) requireAPIVersion(version APIVersion, reason string) error {
+	if !c.APIVersion.AtLeast(version) {
+		return OperationNotAllowedError{Reason: reason}
 	}
-	if c.BasicAuthConfig != nil {
-		request.SetBasicAuth(c.BasicAuthConfig.Username, c.BasicAuthConfig.Password)
+	return nil
+}
+
+// appendAcceptsIncomplete returns fullURL with an accepts_incomplete=true
+// query parameter appended when accepts is true, and fullURL unchanged
+// otherwise.
+func appendAcceptsIncomplete(fullURL string, accepts bool) (string, error) {
+	if !accepts {
+		return fullURL, nil
 	}
 
-	if c.Verbose {
-		glog.Infof("broker %q: doing request to %q", c.Name, URL)
+	var buffer bytes.Buffer
+	if err := appendQueryParam(&buffer, "accepts_incomplete", "true"); err != nil {
+		return "", err
 	}
 
-	return c.httpClient.Do(request)
+	return fullURL + "?" + buffer.String(), nil
 }
 
 // appendQueryParam appends key=value to buffer if value is non-null,
@@ -155,8 +354,8 @@ func (c *client) unmarshalResponse(response *http.Response, obj interface{}) err
 		return err
 	}
 
-	if c.Verbose {
-		glog.Info("broker %q: response body: %v", c.Name, string(body))
+	if c.LogPayloads {
+		c.Logger.Debug("received response", "broker", c.Name, "body", string(body))
 	}
 
 	err = json.Unmarshal(body, obj)
@@ -175,20 +374,26 @@ func (c *client) handleFailureResponse(response *http.Response) error {
 		return err
 	}
 
+	// A 422 whose error is "ConcurrencyError" is returned as a
+	// ConcurrencyError rather than an HTTPStatusCodeError.
+	if response.StatusCode == http.StatusUnprocessableEntity && brokerResponse.Err != nil && *brokerResponse.Err == "ConcurrencyError" {
+		return ConcurrencyError{Description: brokerResponse.Description}
+	}
+
 	return HTTPStatusCodeError{
 		StatusCode:   response.StatusCode,
-		ErrorMessage: brokerResponse.err,
-		Description:  brokerResponse.description,
+		ErrorMessage: brokerResponse.Err,
+		Description:  brokerResponse.Description,
 	}
 }
 
 // internal message body types
 
 type asyncSuccessResponseBody struct {
-	operation *string `json:"operation"`
+	Operation *string `json:"operation"`
 }
 
 type failureResponseBody struct {
-	err         *string `json:"error,omitempty"`
-	description *string `json:"description,omitempty"`
+	Err         *string `json:"error,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
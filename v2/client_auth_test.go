@@ -0,0 +1,137 @@
+package v2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewClientRejectsMultipleAuthConfigs(t *testing.T) {
+	_, err := NewClient(&ClientConfiguration{
+		Name:       "test-broker",
+		URL:        "http://example.com",
+		APIVersion: LatestAPIVersion(),
+		AuthConfig: &AuthConfig{
+			BasicAuthConfig: &BasicAuthConfig{Username: "user", Password: "pass"},
+			BearerConfig:    &BearerConfig{Token: "token"},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected an error when more than one AuthConfig field is set")
+	}
+}
+
+func TestNewClientRejectsEmptyAuthConfig(t *testing.T) {
+	_, err := NewClient(&ClientConfiguration{
+		Name:       "test-broker",
+		URL:        "http://example.com",
+		APIVersion: LatestAPIVersion(),
+		AuthConfig: &AuthConfig{},
+	})
+	if err == nil {
+		t.Fatal("expected an error when no AuthConfig field is set")
+	}
+}
+
+func TestNewClientBasicAuthSendsCredentials(t *testing.T) {
+	var gotUsername, gotPassword string
+	var gotOK bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUsername, gotPassword, gotOK = r.BasicAuth()
+		w.Header().Set(contentType, jsonType)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"services":[]}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(&ClientConfiguration{
+		Name:           "test-broker",
+		URL:            server.URL,
+		APIVersion:     LatestAPIVersion(),
+		TimeoutSeconds: 5,
+		AuthConfig: &AuthConfig{
+			BasicAuthConfig: &BasicAuthConfig{Username: "user", Password: "pass"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := c.GetCatalog(); err != nil {
+		t.Fatalf("GetCatalog: %v", err)
+	}
+
+	if !gotOK || gotUsername != "user" || gotPassword != "pass" {
+		t.Errorf("BasicAuth = (%q, %q, %v), want (\"user\", \"pass\", true)", gotUsername, gotPassword, gotOK)
+	}
+}
+
+func TestNewClientBearerAuthSendsToken(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set(contentType, jsonType)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"services":[]}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(&ClientConfiguration{
+		Name:           "test-broker",
+		URL:            server.URL,
+		APIVersion:     LatestAPIVersion(),
+		TimeoutSeconds: 5,
+		AuthConfig: &AuthConfig{
+			BearerConfig: &BearerConfig{Token: "static-token"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := c.GetCatalog(); err != nil {
+		t.Fatalf("GetCatalog: %v", err)
+	}
+
+	if want := "Bearer static-token"; gotAuth != want {
+		t.Errorf("Authorization = %q, want %q", gotAuth, want)
+	}
+}
+
+func TestNewClientOAuth2FetchesAndSendsToken(t *testing.T) {
+	var gotAuth string
+	resourceServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set(contentType, jsonType)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"services":[]}`))
+	}))
+	defer resourceServer.Close()
+
+	tokenServer := newTestTokenServer(t, []string{"oauth2-token"})
+
+	c, err := NewClient(&ClientConfiguration{
+		Name:           "test-broker",
+		URL:            resourceServer.URL,
+		APIVersion:     LatestAPIVersion(),
+		TimeoutSeconds: 5,
+		AuthConfig: &AuthConfig{
+			OAuth2ClientCredentialsConfig: &OAuth2ClientCredentialsConfig{
+				ClientID:     "client",
+				ClientSecret: "secret",
+				TokenURL:     tokenServer.URL,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := c.GetCatalog(); err != nil {
+		t.Fatalf("GetCatalog: %v", err)
+	}
+
+	if want := "Bearer oauth2-token"; gotAuth != want {
+		t.Errorf("Authorization = %q, want %q", gotAuth, want)
+	}
+}
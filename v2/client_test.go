@@ -0,0 +1,47 @@
+package v2
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestHandleFailureResponseConcurrencyError(t *testing.T) {
+	c := &client{}
+	response := &http.Response{
+		StatusCode: http.StatusUnprocessableEntity,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`{"error":"ConcurrencyError"}`)),
+	}
+
+	err := c.handleFailureResponse(response)
+
+	concurrencyErr, ok := err.(ConcurrencyError)
+	if !ok {
+		t.Fatalf("expected ConcurrencyError, got %T: %v", err, err)
+	}
+	if concurrencyErr.Description != nil {
+		t.Errorf("expected nil Description, got %q", *concurrencyErr.Description)
+	}
+}
+
+func TestHandleFailureResponseOtherStatus(t *testing.T) {
+	c := &client{}
+	response := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`{"error":"SomeError","description":"bad request"}`)),
+	}
+
+	err := c.handleFailureResponse(response)
+
+	statusErr, ok := err.(HTTPStatusCodeError)
+	if !ok {
+		t.Fatalf("expected HTTPStatusCodeError, got %T: %v", err, err)
+	}
+	if statusErr.ErrorMessage == nil || *statusErr.ErrorMessage != "SomeError" {
+		t.Errorf("expected ErrorMessage %q, got %v", "SomeError", statusErr.ErrorMessage)
+	}
+	if statusErr.Description == nil || *statusErr.Description != "bad request" {
+		t.Errorf("expected Description %q, got %v", "bad request", statusErr.Description)
+	}
+}
@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+)
+
+// DeprovisionInstance requests that the broker deprovision a previously
+// provisioned service instance and returns the DeprovisionResponse or an
+// error.
+func (c *client) DeprovisionInstance(r *DeprovisionRequest) (*DeprovisionResponse, error) {
+	fullURL := fmt.Sprintf(serviceInstanceURLFmt, c.URL, r.InstanceID)
+
+	var buffer bytes.Buffer
+	if err := appendQueryParam(&buffer, "service_id", r.ServiceID); err != nil {
+		return nil, err
+	}
+	if err := appendQueryParam(&buffer, "plan_id", r.PlanID); err != nil {
+		return nil, err
+	}
+	if r.AcceptsIncomplete {
+		if err := appendQueryParam(&buffer, "accepts_incomplete", "true"); err != nil {
+			return nil, err
+		}
+	}
+	if buffer.Len() > 0 {
+		fullURL = fullURL + "?" + buffer.String()
+	}
+
+	response, err := c.prepareAndDoRequest(http.MethodDelete, fullURL, nil, r.OriginatingIdentity, r.RetryConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		userResponse := &DeprovisionResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		return userResponse, nil
+	case http.StatusAccepted:
+		userResponse := &DeprovisionResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		userResponse.Async = true
+		return userResponse, nil
+	default:
+		return nil, c.handleFailureResponse(response)
+	}
+}
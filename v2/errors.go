@@ -0,0 +1,45 @@
+package v2
+
+import "fmt"
+
+// HTTPStatusCodeError is returned when a broker returns a response with an
+// HTTP status code that is not 2xx, or that this client does not otherwise
+// know how to handle.
+type HTTPStatusCodeError struct {
+	StatusCode   int
+	ErrorMessage *string
+	Description  *string
+}
+
+func (e HTTPStatusCodeError) Error() string {
+	if e.ErrorMessage != nil && e.Description != nil {
+		return fmt.Sprintf("Status: %d; ErrorMessage: %s; Description: %s", e.StatusCode, *e.ErrorMessage, *e.Description)
+	}
+	return fmt.Sprintf("Status: %d", e.StatusCode)
+}
+
+// ConcurrencyError is returned when the broker responds with a 422 and an
+// error value of "ConcurrencyError", indicating that the instance is
+// already being updated and the caller should retry the request later.
+type ConcurrencyError struct {
+	Description *string
+}
+
+func (e ConcurrencyError) Error() string {
+	if e.Description != nil {
+		return fmt.Sprintf("concurrency error: %s", *e.Description)
+	}
+	return "concurrency error: the instance is being updated, try again later"
+}
+
+// OperationNotAllowedError is returned when the client is asked to perform
+// an operation that the broker, as configured (via its negotiated
+// APIVersion), does not support. The client returns this error without ever
+// sending a request.
+type OperationNotAllowedError struct {
+	Reason string
+}
+
+func (e OperationNotAllowedError) Error() string {
+	return fmt.Sprintf("the requested operation is not allowed: %s", e.Reason)
+}
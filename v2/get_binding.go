@@ -0,0 +1,35 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// GetBinding requests the current state of a service binding from the
+// broker and returns the GetBindingResponse or an error. GetBinding requires
+// a negotiated broker API version of 2.14 or later; on older versions it
+// returns an OperationNotAllowedError without making a request.
+func (c *client) GetBinding(r *GetBindingRequest) (*GetBindingResponse, error) {
+	if err := c.requireAPIVersion(Version2_14(), "GetBinding requires broker API version 2.14 or later"); err != nil {
+		return nil, err
+	}
+
+	fullURL := fmt.Sprintf(bindingURLFmt, c.URL, r.InstanceID, r.BindingID)
+
+	response, err := c.prepareAndDoRequest(http.MethodGet, fullURL, nil, r.OriginatingIdentity, r.RetryConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		userResponse := &GetBindingResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		return userResponse, nil
+	default:
+		return nil, c.handleFailureResponse(response)
+	}
+}
@@ -0,0 +1,29 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// GetCatalog requests the broker's catalog of services and returns the
+// CatalogResponse or an error.
+func (c *client) GetCatalog() (*CatalogResponse, error) {
+	fullURL := fmt.Sprintf(catalogURL, c.URL)
+
+	response, err := c.prepareAndDoRequest(http.MethodGet, fullURL, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		userResponse := &CatalogResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		return userResponse, nil
+	default:
+		return nil, c.handleFailureResponse(response)
+	}
+}
@@ -0,0 +1,37 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// GetInstance requests the current state of a provisioned service instance
+// from the broker and returns the GetInstanceResponse or an error.
+// GetInstance requires a negotiated broker API version of 2.14 or later; on
+// older versions it returns an OperationNotAllowedError without making a
+// request. If the broker reports that the instance is concurrently being
+// updated, the returned error is a ConcurrencyError.
+func (c *client) GetInstance(r *GetInstanceRequest) (*GetInstanceResponse, error) {
+	if err := c.requireAPIVersion(Version2_14(), "GetInstance requires broker API version 2.14 or later"); err != nil {
+		return nil, err
+	}
+
+	fullURL := fmt.Sprintf(serviceInstanceURLFmt, c.URL, r.InstanceID)
+
+	response, err := c.prepareAndDoRequest(http.MethodGet, fullURL, nil, r.OriginatingIdentity, r.RetryConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		userResponse := &GetInstanceResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		return userResponse, nil
+	default:
+		return nil, c.handleFailureResponse(response)
+	}
+}
@@ -0,0 +1,37 @@
+package v2
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+)
+
+// GlogLogger adapts glog to the Logger interface. It exists to preserve the
+// behavior of consumers that already depend on glog from before this client
+// took a pluggable Logger; new consumers should prefer NewSlogLogger.
+type GlogLogger struct{}
+
+func (GlogLogger) Debug(msg string, keyvals ...interface{}) {
+	glog.V(4).Info(formatLogEntry(msg, keyvals))
+}
+
+func (GlogLogger) Info(msg string, keyvals ...interface{}) {
+	glog.Info(formatLogEntry(msg, keyvals))
+}
+
+func (GlogLogger) Warn(msg string, keyvals ...interface{}) {
+	glog.Warning(formatLogEntry(msg, keyvals))
+}
+
+func (GlogLogger) Error(msg string, keyvals ...interface{}) {
+	glog.Error(formatLogEntry(msg, keyvals))
+}
+
+// formatLogEntry renders msg and its key/value pairs the way glog's
+// unstructured API expects.
+func formatLogEntry(msg string, keyvals []interface{}) string {
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		msg = fmt.Sprintf("%s %v=%v", msg, keyvals[i], keyvals[i+1])
+	}
+	return msg
+}
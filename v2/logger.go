@@ -0,0 +1,22 @@
+package v2
+
+// Logger is the structured logging interface used by the client. Each
+// method takes a human-readable message followed by an even number of
+// key/value pairs describing the event. Implementations should not panic on
+// an odd number of keyvals; dropping the trailing key is acceptable.
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+	Info(msg string, keyvals ...interface{})
+	Warn(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
+
+// noopLogger is the Logger used when a ClientConfiguration does not specify
+// one. It discards everything, so consumers of this module are not forced
+// to configure a logger or pull in any particular logging library.
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...interface{}) {}
+func (noopLogger) Info(string, ...interface{})  {}
+func (noopLogger) Warn(string, ...interface{})  {}
+func (noopLogger) Error(string, ...interface{}) {}
@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type recordingLogger struct {
+	entries []string
+}
+
+func (l *recordingLogger) record(level, msg string, keyvals ...interface{}) {
+	l.entries = append(l.entries, fmt.Sprintf("%s: %s %v", level, msg, keyvals))
+}
+
+func (l *recordingLogger) Debug(msg string, keyvals ...interface{}) {
+	l.record("debug", msg, keyvals...)
+}
+func (l *recordingLogger) Info(msg string, keyvals ...interface{}) { l.record("info", msg, keyvals...) }
+func (l *recordingLogger) Warn(msg string, keyvals ...interface{}) { l.record("warn", msg, keyvals...) }
+func (l *recordingLogger) Error(msg string, keyvals ...interface{}) {
+	l.record("error", msg, keyvals...)
+}
+
+func (l *recordingLogger) contains(substr string) bool {
+	for _, entry := range l.entries {
+		if strings.Contains(entry, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestLogPayloadsFalseDoesNotLogCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, jsonType)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"credentials":{"password":"super-secret"}}`))
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	c, err := NewClient(&ClientConfiguration{
+		Name:           "test-broker",
+		URL:            server.URL,
+		APIVersion:     LatestAPIVersion(),
+		TimeoutSeconds: 5,
+		Logger:         logger,
+		LogPayloads:    false,
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := c.Bind(&BindRequest{InstanceID: "instance-1", BindingID: "binding-1", ServiceID: "svc", PlanID: "plan"}); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+
+	if logger.contains("super-secret") {
+		t.Errorf("logger entries leaked the response body with LogPayloads=false: %v", logger.entries)
+	}
+	if !logger.contains("did request") {
+		t.Error("expected an info-level entry recording that the request was made")
+	}
+}
+
+func TestLogPayloadsTrueLogsRequestAndResponseBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, jsonType)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"credentials":{"password":"super-secret"}}`))
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	c, err := NewClient(&ClientConfiguration{
+		Name:           "test-broker",
+		URL:            server.URL,
+		APIVersion:     LatestAPIVersion(),
+		TimeoutSeconds: 5,
+		Logger:         logger,
+		LogPayloads:    true,
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := c.Bind(&BindRequest{InstanceID: "instance-1", BindingID: "binding-1", ServiceID: "svc", PlanID: "plan"}); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+
+	if !logger.contains("super-secret") {
+		t.Errorf("expected the response body to be logged with LogPayloads=true, got: %v", logger.entries)
+	}
+	if !logger.contains("sending request") {
+		t.Error("expected a debug-level entry logging the outgoing request body")
+	}
+}
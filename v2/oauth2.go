@@ -0,0 +1,143 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryLeeway is subtracted from a token's reported lifetime so it is
+// refreshed slightly before the broker's token endpoint considers it
+// expired. A token whose reported lifetime is no longer than the leeway is
+// cached for the leeway itself.
+const tokenExpiryLeeway = 30 * time.Second
+
+// oauth2Transport wraps an http.RoundTripper, attaching an OAuth2
+// client-credentials bearer token to every request. The token is cached
+// until it is near expiry and is refreshed early if the wrapped
+// round-tripper reports a 401.
+type oauth2Transport struct {
+	config *OAuth2ClientCredentialsConfig
+	base   http.RoundTripper
+	client *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// newOAuth2Transport returns an http.RoundTripper that performs the OAuth2
+// client-credentials flow against config using base for the underlying
+// connections, including fetching the token itself.
+func newOAuth2Transport(config *OAuth2ClientCredentialsConfig, base http.RoundTripper) *oauth2Transport {
+	return &oauth2Transport{
+		config: config,
+		base:   base,
+		client: &http.Client{Transport: base},
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *oauth2Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	token, err := t.token(false)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	response, err := t.base.RoundTrip(request)
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+
+	token, err = t.token(true)
+	if err != nil {
+		return response, nil
+	}
+
+	retry := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return response, nil
+		}
+		retry.Body = body
+	}
+	retry.Header.Set("Authorization", "Bearer "+token)
+
+	response.Body.Close()
+	return t.base.RoundTrip(retry)
+}
+
+// token returns a cached access token, or fetches a fresh one if the cache
+// is empty, expired, or forceRefresh is set.
+func (t *oauth2Transport) token(forceRefresh bool) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !forceRefresh && t.accessToken != "" && time.Now().Before(t.expiresAt) {
+		return t.accessToken, nil
+	}
+
+	token, expiresIn, err := t.fetchToken()
+	if err != nil {
+		return "", err
+	}
+
+	t.accessToken = token
+	t.expiresAt = time.Now().Add(expiresIn)
+	return t.accessToken, nil
+}
+
+// fetchToken performs the client-credentials grant against t.config.TokenURL
+// and returns the access token and how long it remains valid.
+func (t *oauth2Transport) fetchToken() (string, time.Duration, error) {
+	values := url.Values{}
+	values.Set("grant_type", "client_credentials")
+	values.Set("client_id", t.config.ClientID)
+	values.Set("client_secret", t.config.ClientSecret)
+	if len(t.config.Scopes) > 0 {
+		values.Set("scope", strings.Join(t.config.Scopes, " "))
+	}
+	if t.config.Audience != "" {
+		values.Set("audience", t.config.Audience)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, t.config.TokenURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	request.Header.Set(contentType, "application/x-www-form-urlencoded")
+
+	response, err := t.client.Do(request)
+	if err != nil {
+		return "", 0, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("oauth2: token endpoint returned status %d", response.StatusCode)
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&tokenResponse); err != nil {
+		return "", 0, err
+	}
+	if tokenResponse.AccessToken == "" {
+		return "", 0, errors.New("oauth2: token endpoint response did not include an access_token")
+	}
+
+	expiresIn := time.Duration(tokenResponse.ExpiresIn)*time.Second - tokenExpiryLeeway
+	if expiresIn <= 0 {
+		expiresIn = tokenExpiryLeeway
+	}
+	return tokenResponse.AccessToken, expiresIn, nil
+}
@@ -0,0 +1,114 @@
+package v2
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestTokenServer(t *testing.T, tokens []string) *httptest.Server {
+	t.Helper()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls >= len(tokens) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		token := tokens[calls]
+		calls++
+		json.NewEncoder(w).Encode(struct {
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int64  `json:"expires_in"`
+		}{AccessToken: token, ExpiresIn: 3600})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestOAuth2TransportRefreshesOnUnauthorized verifies that a 401 from a
+// bodyless (GET) request still triggers a forced token refresh and retry.
+func TestOAuth2TransportRefreshesOnUnauthorized(t *testing.T) {
+	var sawTokens []string
+	resourceServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Authorization")
+		sawTokens = append(sawTokens, token)
+		if token == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer resourceServer.Close()
+
+	tokenServer := newTestTokenServer(t, []string{"token-1", "token-2"})
+
+	transport := newOAuth2Transport(&OAuth2ClientCredentialsConfig{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		TokenURL:     tokenServer.URL,
+	}, http.DefaultTransport)
+
+	request, err := http.NewRequest(http.MethodGet, resourceServer.URL, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", response.StatusCode, http.StatusOK)
+	}
+	if want := []string{"Bearer token-1", "Bearer token-2"}; len(sawTokens) != len(want) || sawTokens[0] != want[0] || sawTokens[1] != want[1] {
+		t.Errorf("sawTokens = %v, want %v", sawTokens, want)
+	}
+}
+
+// TestOAuth2TransportReturnsReadableBodyWhenRefreshFails verifies that when
+// the forced refresh after a 401 itself fails, the original 401 response is
+// returned with its body still readable (not already closed).
+func TestOAuth2TransportReturnsReadableBodyWhenRefreshFails(t *testing.T) {
+	const unauthorizedBody = `{"error":"invalid_token"}`
+	resourceServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(unauthorizedBody))
+	}))
+	defer resourceServer.Close()
+
+	tokenServer := newTestTokenServer(t, []string{"token-1"})
+
+	transport := newOAuth2Transport(&OAuth2ClientCredentialsConfig{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		TokenURL:     tokenServer.URL,
+	}, http.DefaultTransport)
+
+	request, err := http.NewRequest(http.MethodGet, resourceServer.URL, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("StatusCode = %d, want %d", response.StatusCode, http.StatusUnauthorized)
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %v", err)
+	}
+	if string(body) != unauthorizedBody {
+		t.Errorf("body = %q, want %q", body, unauthorizedBody)
+	}
+}
@@ -0,0 +1,125 @@
+package v2
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSetOriginatingIdentityHeaderRoundTrips(t *testing.T) {
+	request, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	identity := &OriginatingIdentity{
+		Platform: "cloudfoundry",
+		Value:    map[string]interface{}{"user_id": "user-123"},
+	}
+
+	if err := setOriginatingIdentityHeader(request, identity); err != nil {
+		t.Fatalf("setOriginatingIdentityHeader: %v", err)
+	}
+
+	header := request.Header.Get(XBrokerAPIOriginatingIdentity)
+	platform, encodedValue, found := strings.Cut(header, " ")
+	if !found {
+		t.Fatalf("header %q did not contain a space-separated platform and value", header)
+	}
+	if platform != identity.Platform {
+		t.Errorf("platform = %q, want %q", platform, identity.Platform)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encodedValue)
+	if err != nil {
+		t.Fatalf("decoding value: %v", err)
+	}
+	var value map[string]interface{}
+	if err := json.Unmarshal(decoded, &value); err != nil {
+		t.Fatalf("unmarshaling value: %v", err)
+	}
+	if value["user_id"] != "user-123" {
+		t.Errorf("value[user_id] = %v, want %q", value["user_id"], "user-123")
+	}
+}
+
+func TestSetOriginatingIdentityHeaderNilIdentityIsNoop(t *testing.T) {
+	request, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	if err := setOriginatingIdentityHeader(request, nil); err != nil {
+		t.Fatalf("setOriginatingIdentityHeader: %v", err)
+	}
+
+	if header := request.Header.Get(XBrokerAPIOriginatingIdentity); header != "" {
+		t.Errorf("header = %q, want empty", header)
+	}
+}
+
+// TestProvisionInstanceOriginatingIdentityHeader verifies that
+// X-Broker-Api-Originating-Identity is sent only when EnableOriginatingIdentity
+// is set and the negotiated APIVersion is at least 2.13.
+func TestProvisionInstanceOriginatingIdentityHeader(t *testing.T) {
+	identity := &OriginatingIdentity{
+		Platform: "cloudfoundry",
+		Value:    map[string]interface{}{"user_id": "user-123"},
+	}
+
+	cases := []struct {
+		name              string
+		enable            bool
+		apiVersion        APIVersion
+		wantHeaderPresent bool
+	}{
+		{"enabled at 2.13", true, Version2_13(), true},
+		{"enabled at 2.14", true, Version2_14(), true},
+		{"enabled below 2.13", true, Version2_12(), false},
+		{"disabled at 2.14", false, Version2_14(), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotHeader string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotHeader = r.Header.Get(XBrokerAPIOriginatingIdentity)
+				w.Header().Set(contentType, jsonType)
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			c, err := NewClient(&ClientConfiguration{
+				Name:                      "test-broker",
+				URL:                       server.URL,
+				APIVersion:                tc.apiVersion,
+				TimeoutSeconds:            5,
+				EnableOriginatingIdentity: tc.enable,
+			})
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			r := &ProvisionRequest{
+				InstanceID:          "instance-1",
+				ServiceID:           "svc",
+				PlanID:              "plan",
+				OriginatingIdentity: identity,
+			}
+			if _, err := c.ProvisionInstance(r); err != nil {
+				t.Fatalf("ProvisionInstance: %v", err)
+			}
+
+			if tc.wantHeaderPresent && gotHeader == "" {
+				t.Errorf("expected %s header to be set, got none", XBrokerAPIOriginatingIdentity)
+			}
+			if !tc.wantHeaderPresent && gotHeader != "" {
+				t.Errorf("expected no %s header, got %q", XBrokerAPIOriginatingIdentity, gotHeader)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+)
+
+// PollBindingLastOperation requests the state of an in-progress binding or
+// unbinding operation from the broker and returns the LastOperationResponse
+// or an error. PollBindingLastOperation requires a negotiated broker API
+// version of 2.14 or later; on older versions it returns an
+// OperationNotAllowedError without making a request.
+func (c *client) PollBindingLastOperation(r *BindingLastOperationRequest) (*LastOperationResponse, error) {
+	if err := c.requireAPIVersion(Version2_14(), "PollBindingLastOperation requires broker API version 2.14 or later"); err != nil {
+		return nil, err
+	}
+
+	fullURL := fmt.Sprintf(bindingLastOperationURLFmt, c.URL, r.InstanceID, r.BindingID)
+
+	var buffer bytes.Buffer
+	if r.ServiceID != nil {
+		if err := appendQueryParam(&buffer, "service_id", *r.ServiceID); err != nil {
+			return nil, err
+		}
+	}
+	if r.PlanID != nil {
+		if err := appendQueryParam(&buffer, "plan_id", *r.PlanID); err != nil {
+			return nil, err
+		}
+	}
+	if r.OperationKey != nil {
+		if err := appendQueryParam(&buffer, "operation", string(*r.OperationKey)); err != nil {
+			return nil, err
+		}
+	}
+	if buffer.Len() > 0 {
+		fullURL = fullURL + "?" + buffer.String()
+	}
+
+	response, err := c.prepareAndDoRequest(http.MethodGet, fullURL, nil, r.OriginatingIdentity, r.RetryConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		userResponse := &LastOperationResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		return userResponse, nil
+	default:
+		return nil, c.handleFailureResponse(response)
+	}
+}
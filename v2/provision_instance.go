@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ProvisionInstance requests that the broker provision a new service
+// instance and returns the ProvisionResponse or an error.
+func (c *client) ProvisionInstance(r *ProvisionRequest) (*ProvisionResponse, error) {
+	fullURL := fmt.Sprintf(serviceInstanceURLFmt, c.URL, r.InstanceID)
+
+	fullURL, err := appendAcceptsIncomplete(fullURL, r.AcceptsIncomplete)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.prepareAndDoRequest(http.MethodPut, fullURL, r, r.OriginatingIdentity, r.RetryConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		userResponse := &ProvisionResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		return userResponse, nil
+	case http.StatusAccepted:
+		userResponse := &ProvisionResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		userResponse.Async = true
+		return userResponse, nil
+	default:
+		return nil, c.handleFailureResponse(response)
+	}
+}
@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, retryConfig *RetryConfig) (*client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(&ClientConfiguration{
+		Name:           "test-broker",
+		URL:            server.URL,
+		APIVersion:     LatestAPIVersion(),
+		TimeoutSeconds: 5,
+		RetryConfig:    retryConfig,
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c.(*client), server
+}
+
+// TestGetInstanceRetriesOnTransientError verifies that a client-level
+// RetryConfig causes a transient 503 to be retried until it succeeds.
+func TestGetInstanceRetriesOnTransientError(t *testing.T) {
+	attempts := 0
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set(contentType, jsonType)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"service_id":"svc","plan_id":"plan"}`))
+	}, &RetryConfig{
+		MaxAttempts:      3,
+		RetryStatusCodes: []int{http.StatusServiceUnavailable},
+	})
+
+	resp, err := c.GetInstance(&GetInstanceRequest{InstanceID: "instance-1"})
+	if err != nil {
+		t.Fatalf("GetInstance: %v", err)
+	}
+	if resp.ServiceID != "svc" {
+		t.Errorf("ServiceID = %q, want %q", resp.ServiceID, "svc")
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+// TestGetInstanceRequestRetryConfigOverridesClient verifies that a request's
+// own RetryConfig overrides the client-level default, so a request with
+// MaxAttempts 1 is attempted once even though the client would retry it.
+func TestGetInstanceRequestRetryConfigOverridesClient(t *testing.T) {
+	attempts := 0
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, &RetryConfig{
+		MaxAttempts:      3,
+		RetryStatusCodes: []int{http.StatusServiceUnavailable},
+	})
+
+	_, err := c.GetInstance(&GetInstanceRequest{
+		InstanceID:  "instance-1",
+		RetryConfig: &RetryConfig{MaxAttempts: 1},
+	})
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1 (request RetryConfig should disable client retries)", attempts)
+	}
+}
@@ -0,0 +1,29 @@
+package v2
+
+import "log/slog"
+
+// SlogLogger adapts a *slog.Logger to the Logger interface.
+type SlogLogger struct {
+	Logger *slog.Logger
+}
+
+// NewSlogLogger returns a Logger backed by the given *slog.Logger.
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return &SlogLogger{Logger: logger}
+}
+
+func (l *SlogLogger) Debug(msg string, keyvals ...interface{}) {
+	l.Logger.Debug(msg, keyvals...)
+}
+
+func (l *SlogLogger) Info(msg string, keyvals ...interface{}) {
+	l.Logger.Info(msg, keyvals...)
+}
+
+func (l *SlogLogger) Warn(msg string, keyvals ...interface{}) {
+	l.Logger.Warn(msg, keyvals...)
+}
+
+func (l *SlogLogger) Error(msg string, keyvals ...interface{}) {
+	l.Logger.Error(msg, keyvals...)
+}
@@ -0,0 +1,409 @@
+package v2
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+// This file contains the types that make up the Client interface and the
+// request/response bodies for each of its methods.
+
+// Client is the interface for the Open Service Broker API client.
+type Client interface {
+	GetCatalog() (*CatalogResponse, error)
+	ProvisionInstance(r *ProvisionRequest) (*ProvisionResponse, error)
+	UpdateInstance(r *UpdateInstanceRequest) (*UpdateInstanceResponse, error)
+	DeprovisionInstance(r *DeprovisionRequest) (*DeprovisionResponse, error)
+	PollLastOperation(r *LastOperationRequest) (*LastOperationResponse, error)
+	Bind(r *BindRequest) (*BindResponse, error)
+	Unbind(r *UnbindRequest) (*UnbindResponse, error)
+	GetBinding(r *GetBindingRequest) (*GetBindingResponse, error)
+	PollBindingLastOperation(r *BindingLastOperationRequest) (*LastOperationResponse, error)
+	GetInstance(r *GetInstanceRequest) (*GetInstanceResponse, error)
+}
+
+// CreateFunc is the function type used to create new Client implementations.
+type CreateFunc func(*ClientConfiguration) (Client, error)
+
+// ClientConfiguration holds the configuration necessary to create a new
+// Client that is functional.
+type ClientConfiguration struct {
+	Name                      string
+	URL                       string
+	APIVersion                APIVersion
+	AuthConfig                *AuthConfig
+	TimeoutSeconds            int
+	EnableAlphaFeatures       bool
+	EnableOriginatingIdentity bool
+	TLSConfig                 *tls.Config
+	Insecure                  bool
+
+	// Logger receives structured log entries for every request this client
+	// makes. It defaults to a no-op implementation; see NewSlogLogger and
+	// GlogLogger for ready-made adapters.
+	Logger Logger
+
+	// LogPayloads, when true, includes request and response bodies in the
+	// entries sent to Logger. It defaults to false so that credentials
+	// returned in bind responses are not logged.
+	LogPayloads bool
+
+	// RetryConfig configures retries of idempotent requests. It is nil by
+	// default, meaning requests are attempted exactly once; see
+	// DefaultRetryConfig for a reasonable starting point. A request's own
+	// RetryConfig field, if set, overrides this for that request.
+	RetryConfig *RetryConfig
+}
+
+// RetryConfig configures the exponential-backoff retry behavior applied to
+// idempotent broker requests.
+type RetryConfig struct {
+	// MaxAttempts is the total number of times a request is attempted,
+	// including the first attempt. Values less than 1 are treated as 1 (no
+	// retries).
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the computed exponential backoff between retries. Zero
+	// means uncapped. A delay taken from a broker's Retry-After header is not
+	// capped.
+	MaxBackoff time.Duration
+
+	// Jitter, when true, applies full jitter to each computed backoff
+	// (a random delay in [0, backoff]) to avoid thundering-herd retries.
+	Jitter bool
+
+	// RetryStatusCodes lists the HTTP status codes that are retried. A
+	// network-layer error is always retried regardless of this list.
+	RetryStatusCodes []int
+
+	// OnRetry, if set, is called before each retry so callers can observe
+	// retries for metrics. delay is how long the client will sleep before
+	// the next attempt.
+	OnRetry func(attempt int, statusCode int, err error, delay time.Duration)
+}
+
+// DefaultRetryConfig returns a RetryConfig with reasonable defaults: three
+// attempts, full-jitter exponential backoff starting at 200ms and capped at
+// 5s, retrying on 429 and the common transient 5xx status codes.
+func DefaultRetryConfig() *RetryConfig {
+	return &RetryConfig{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Jitter:         true,
+		RetryStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// DefaultClientConfiguration returns a default ClientConfiguration that is
+// useful for most use cases.
+func DefaultClientConfiguration() *ClientConfiguration {
+	return &ClientConfiguration{
+		Name:           "Broker",
+		APIVersion:     LatestAPIVersion(),
+		TimeoutSeconds: 60,
+	}
+}
+
+// AuthConfig is a union-type holding the authentication configuration for a
+// given broker. Exactly one of its fields may be set.
+type AuthConfig struct {
+	BasicAuthConfig               *BasicAuthConfig
+	BearerConfig                  *BearerConfig
+	OAuth2ClientCredentialsConfig *OAuth2ClientCredentialsConfig
+}
+
+// BasicAuthConfig holds the configuration for basic auth.
+type BasicAuthConfig struct {
+	Username string
+	Password string
+}
+
+// BearerConfig holds the configuration for a static bearer token.
+type BearerConfig struct {
+	Token string
+}
+
+// OAuth2ClientCredentialsConfig holds the configuration for authenticating
+// to the broker via the OAuth2 client-credentials flow. The client
+// transparently fetches and refreshes the access token from TokenURL.
+type OAuth2ClientCredentialsConfig struct {
+	ClientID     string
+	ClientSecret string
+	TokenURL     string
+	Scopes       []string
+	Audience     string
+}
+
+// OriginatingIdentity identifies the end user that triggered a given
+// request, so that brokers can attribute the action appropriately. It is
+// sent via the X-Broker-Api-Originating-Identity header as "Platform
+// base64(JSON(Value))".
+type OriginatingIdentity struct {
+	Platform string
+	Value    map[string]interface{}
+}
+
+// OperationKey is the string that a broker may return to identify an
+// in-progress asynchronous operation.
+type OperationKey string
+
+// LastOperationState is the state of a given operation as last reported by
+// the broker.
+type LastOperationState string
+
+const (
+	// StateInProgress is the state for an operation that is ongoing.
+	StateInProgress LastOperationState = "in progress"
+	// StateSucceeded is the state for an operation that has completed
+	// successfully.
+	StateSucceeded LastOperationState = "succeeded"
+	// StateFailed is the state for an operation that has failed.
+	StateFailed LastOperationState = "failed"
+)
+
+// CatalogResponse is sent as the response to a catalog request.
+type CatalogResponse struct {
+	Services []Service `json:"services"`
+}
+
+// Service describes a single service offered by a broker.
+type Service struct {
+	Name        string                 `json:"name"`
+	ID          string                 `json:"id"`
+	Description string                 `json:"description"`
+	Bindable    bool                   `json:"bindable"`
+	Plans       []Plan                 `json:"plans"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Plan describes a single plan offered for a Service.
+type Plan struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Free        *bool                  `json:"free,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ProvisionRequest contains the parameters accepted by the provision
+// instance endpoint.
+type ProvisionRequest struct {
+	InstanceID          string
+	AcceptsIncomplete   bool
+	OriginatingIdentity *OriginatingIdentity
+
+	// RetryConfig, if non-nil, overrides the client's RetryConfig for this
+	// request.
+	RetryConfig *RetryConfig
+
+	ServiceID        string                 `json:"service_id"`
+	PlanID           string                 `json:"plan_id"`
+	OrganizationGUID string                 `json:"organization_guid,omitempty"`
+	SpaceGUID        string                 `json:"space_guid,omitempty"`
+	Context          map[string]interface{} `json:"context,omitempty"`
+	Parameters       map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ProvisionResponse is sent in response to a provision instance request.
+type ProvisionResponse struct {
+	Async        bool
+	DashboardURL *string       `json:"dashboard_url,omitempty"`
+	OperationKey *OperationKey `json:"operation,omitempty"`
+}
+
+// PreviousValues holds the previous state of a service instance being
+// updated, for the broker's benefit.
+type PreviousValues struct {
+	PlanID         string `json:"plan_id,omitempty"`
+	ServiceID      string `json:"service_id,omitempty"`
+	OrganizationID string `json:"organization_id,omitempty"`
+	SpaceID        string `json:"space_id,omitempty"`
+}
+
+// UpdateInstanceRequest contains the parameters accepted by the update
+// instance endpoint.
+type UpdateInstanceRequest struct {
+	InstanceID          string
+	AcceptsIncomplete   bool
+	OriginatingIdentity *OriginatingIdentity
+
+	// RetryConfig, if non-nil, overrides the client's RetryConfig for this
+	// request.
+	RetryConfig *RetryConfig
+
+	ServiceID      string                 `json:"service_id"`
+	PlanID         *string                `json:"plan_id,omitempty"`
+	Parameters     map[string]interface{} `json:"parameters,omitempty"`
+	Context        map[string]interface{} `json:"context,omitempty"`
+	PreviousValues *PreviousValues        `json:"previous_values,omitempty"`
+}
+
+// UpdateInstanceResponse is sent in response to an update instance request.
+type UpdateInstanceResponse struct {
+	Async        bool
+	DashboardURL *string       `json:"dashboard_url,omitempty"`
+	OperationKey *OperationKey `json:"operation,omitempty"`
+}
+
+// DeprovisionRequest contains the parameters accepted by the deprovision
+// instance endpoint.
+type DeprovisionRequest struct {
+	InstanceID          string
+	ServiceID           string
+	PlanID              string
+	AcceptsIncomplete   bool
+	OriginatingIdentity *OriginatingIdentity
+
+	// RetryConfig, if non-nil, overrides the client's RetryConfig for this
+	// request.
+	RetryConfig *RetryConfig
+}
+
+// DeprovisionResponse is sent in response to a deprovision instance request.
+type DeprovisionResponse struct {
+	Async        bool
+	OperationKey *OperationKey `json:"operation,omitempty"`
+}
+
+// LastOperationRequest contains the parameters accepted by the poll last
+// operation endpoint.
+type LastOperationRequest struct {
+	InstanceID          string
+	ServiceID           *string
+	PlanID              *string
+	OperationKey        *OperationKey
+	OriginatingIdentity *OriginatingIdentity
+
+	// RetryConfig, if non-nil, overrides the client's RetryConfig for this
+	// request.
+	RetryConfig *RetryConfig
+}
+
+// LastOperationResponse is sent in response to a poll last operation
+// request.
+type LastOperationResponse struct {
+	State       LastOperationState `json:"state"`
+	Description *string            `json:"description,omitempty"`
+}
+
+// BindResource identifies the application or route that a binding is being
+// created for.
+type BindResource struct {
+	AppGUID *string `json:"app_guid,omitempty"`
+	Route   *string `json:"route,omitempty"`
+}
+
+// BindRequest contains the parameters accepted by the bind endpoint.
+type BindRequest struct {
+	InstanceID          string
+	BindingID           string
+	AcceptsIncomplete   bool
+	OriginatingIdentity *OriginatingIdentity
+
+	// RetryConfig, if non-nil, overrides the client's RetryConfig for this
+	// request.
+	RetryConfig *RetryConfig
+
+	ServiceID    string                 `json:"service_id"`
+	PlanID       string                 `json:"plan_id"`
+	AppGUID      *string                `json:"app_guid,omitempty"`
+	BindResource *BindResource          `json:"bind_resource,omitempty"`
+	Context      map[string]interface{} `json:"context,omitempty"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// BindResponse is sent in response to a bind request.
+type BindResponse struct {
+	Async           bool
+	Credentials     map[string]interface{} `json:"credentials,omitempty"`
+	SyslogDrainURL  *string                `json:"syslog_drain_url,omitempty"`
+	RouteServiceURL *string                `json:"route_service_url,omitempty"`
+	VolumeMounts    []interface{}          `json:"volume_mounts,omitempty"`
+	OperationKey    *OperationKey          `json:"operation,omitempty"`
+}
+
+// UnbindRequest contains the parameters accepted by the unbind endpoint.
+type UnbindRequest struct {
+	InstanceID          string
+	BindingID           string
+	ServiceID           string
+	PlanID              string
+	AcceptsIncomplete   bool
+	OriginatingIdentity *OriginatingIdentity
+
+	// RetryConfig, if non-nil, overrides the client's RetryConfig for this
+	// request.
+	RetryConfig *RetryConfig
+}
+
+// UnbindResponse is sent in response to an unbind request.
+type UnbindResponse struct {
+	Async        bool
+	OperationKey *OperationKey `json:"operation,omitempty"`
+}
+
+// GetBindingRequest contains the parameters accepted by the get binding
+// endpoint.
+type GetBindingRequest struct {
+	InstanceID          string
+	BindingID           string
+	OriginatingIdentity *OriginatingIdentity
+
+	// RetryConfig, if non-nil, overrides the client's RetryConfig for this
+	// request.
+	RetryConfig *RetryConfig
+}
+
+// GetBindingResponse is sent in response to a get binding request.
+type GetBindingResponse struct {
+	Credentials     map[string]interface{} `json:"credentials,omitempty"`
+	SyslogDrainURL  *string                `json:"syslog_drain_url,omitempty"`
+	RouteServiceURL *string                `json:"route_service_url,omitempty"`
+	VolumeMounts    []interface{}          `json:"volume_mounts,omitempty"`
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// GetInstanceRequest contains the parameters accepted by the get instance
+// endpoint.
+type GetInstanceRequest struct {
+	InstanceID          string
+	OriginatingIdentity *OriginatingIdentity
+
+	// RetryConfig, if non-nil, overrides the client's RetryConfig for this
+	// request.
+	RetryConfig *RetryConfig
+}
+
+// GetInstanceResponse is sent in response to a get instance request.
+type GetInstanceResponse struct {
+	ServiceID    string                 `json:"service_id,omitempty"`
+	PlanID       string                 `json:"plan_id,omitempty"`
+	DashboardURL *string                `json:"dashboard_url,omitempty"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// BindingLastOperationRequest contains the parameters accepted by the poll
+// binding last operation endpoint.
+type BindingLastOperationRequest struct {
+	InstanceID          string
+	BindingID           string
+	ServiceID           *string
+	PlanID              *string
+	OperationKey        *OperationKey
+	OriginatingIdentity *OriginatingIdentity
+
+	// RetryConfig, if non-nil, overrides the client's RetryConfig for this
+	// request.
+	RetryConfig *RetryConfig
+}
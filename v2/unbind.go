@@ -0,0 +1,55 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+)
+
+// Unbind requests that the broker delete a previously created binding and
+// returns the UnbindResponse or an error. If r.AcceptsIncomplete is set, the
+// broker may respond asynchronously; callers should check
+// UnbindResponse.Async and poll PollBindingLastOperation until it completes.
+func (c *client) Unbind(r *UnbindRequest) (*UnbindResponse, error) {
+	fullURL := fmt.Sprintf(bindingURLFmt, c.URL, r.InstanceID, r.BindingID)
+
+	var buffer bytes.Buffer
+	if err := appendQueryParam(&buffer, "service_id", r.ServiceID); err != nil {
+		return nil, err
+	}
+	if err := appendQueryParam(&buffer, "plan_id", r.PlanID); err != nil {
+		return nil, err
+	}
+	if r.AcceptsIncomplete && c.APIVersion.AtLeast(Version2_14()) {
+		if err := appendQueryParam(&buffer, "accepts_incomplete", "true"); err != nil {
+			return nil, err
+		}
+	}
+	if buffer.Len() > 0 {
+		fullURL = fullURL + "?" + buffer.String()
+	}
+
+	response, err := c.prepareAndDoRequest(http.MethodDelete, fullURL, nil, r.OriginatingIdentity, r.RetryConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		userResponse := &UnbindResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		return userResponse, nil
+	case http.StatusAccepted:
+		userResponse := &UnbindResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		userResponse.Async = true
+		return userResponse, nil
+	default:
+		return nil, c.handleFailureResponse(response)
+	}
+}
@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// UpdateInstance requests that the broker update a previously provisioned
+// service instance and returns the UpdateInstanceResponse or an error.
+func (c *client) UpdateInstance(r *UpdateInstanceRequest) (*UpdateInstanceResponse, error) {
+	fullURL := fmt.Sprintf(serviceInstanceURLFmt, c.URL, r.InstanceID)
+
+	fullURL, err := appendAcceptsIncomplete(fullURL, r.AcceptsIncomplete)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.prepareAndDoRequest(http.MethodPatch, fullURL, r, r.OriginatingIdentity, r.RetryConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		userResponse := &UpdateInstanceResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		return userResponse, nil
+	case http.StatusAccepted:
+		userResponse := &UpdateInstanceResponse{}
+		if err := c.unmarshalResponse(response, userResponse); err != nil {
+			return nil, err
+		}
+		userResponse.Async = true
+		return userResponse, nil
+	default:
+		return nil, c.handleFailureResponse(response)
+	}
+}
@@ -0,0 +1,63 @@
+package v2
+
+import (
+	"strconv"
+	"strings"
+)
+
+// APIVersion is a type wrapper for the Open Service Broker API version used
+// in the X-Broker-Api-Version header on every request.
+type APIVersion string
+
+// Version2_11 returns the APIVersion for version 2.11 of the Open Service
+// Broker API.
+func Version2_11() APIVersion {
+	return APIVersion("2.11")
+}
+
+// Version2_12 returns the APIVersion for version 2.12 of the Open Service
+// Broker API.
+func Version2_12() APIVersion {
+	return APIVersion("2.12")
+}
+
+// Version2_13 returns the APIVersion for version 2.13 of the Open Service
+// Broker API.
+func Version2_13() APIVersion {
+	return APIVersion("2.13")
+}
+
+// Version2_14 returns the APIVersion for version 2.14 of the Open Service
+// Broker API.
+func Version2_14() APIVersion {
+	return APIVersion("2.14")
+}
+
+// LatestAPIVersion returns the latest APIVersion supported by this client.
+func LatestAPIVersion() APIVersion {
+	return Version2_14()
+}
+
+// AtLeast returns whether v is greater than or equal to version. Versions
+// are compared numerically by major and then minor component, since a
+// lexicographic comparison would rank "2.9" above "2.14".
+func (v APIVersion) AtLeast(version APIVersion) bool {
+	vMajor, vMinor := v.parse()
+	otherMajor, otherMinor := version.parse()
+
+	if vMajor != otherMajor {
+		return vMajor > otherMajor
+	}
+	return vMinor >= otherMinor
+}
+
+// parse splits v into its major and minor components. A component that is
+// missing or not a valid integer is treated as 0.
+func (v APIVersion) parse() (major, minor int) {
+	parts := strings.SplitN(string(v), ".", 2)
+	major, _ = strconv.Atoi(parts[0])
+	if len(parts) > 1 {
+		minor, _ = strconv.Atoi(parts[1])
+	}
+	return major, minor
+}
@@ -0,0 +1,25 @@
+package v2
+
+import "testing"
+
+func TestAPIVersionAtLeast(t *testing.T) {
+	cases := []struct {
+		v       APIVersion
+		other   APIVersion
+		atLeast bool
+	}{
+		{Version2_14(), Version2_14(), true},
+		{Version2_13(), Version2_14(), false},
+		{Version2_14(), Version2_13(), true},
+		{APIVersion("2.9"), Version2_14(), false},
+		{Version2_14(), APIVersion("2.9"), true},
+		{APIVersion("3.0"), Version2_14(), true},
+		{APIVersion("2.2"), APIVersion("2.10"), false},
+	}
+
+	for _, tc := range cases {
+		if got := tc.v.AtLeast(tc.other); got != tc.atLeast {
+			t.Errorf("APIVersion(%q).AtLeast(%q) = %v, want %v", tc.v, tc.other, got, tc.atLeast)
+		}
+	}
+}